@@ -2,52 +2,47 @@ package ast
 
 import (
 	"fmt"
-	"github.com/NicoNex/tau/obj"
+	"github.com/NicoNex/tau/compiler"
 )
 
 type Less struct {
-	l Node
-	r Node
+	l   Node
+	r   Node
+	pos Pos
 }
 
-func NewLess(l, r Node) Node {
-	return Less{l, r}
+// NewLess returns a new Less node, pos being the position of the '<' token.
+func NewLess(l, r Node, pos Pos) Node {
+	return Less{l, r, pos}
 }
 
-func (l Less) Eval(env *obj.Env) obj.Object {
-	var left = l.l.Eval(env)
-	var right = l.r.Eval(env)
-
-	if isError(left) {
-		return left
-	}
-	if isError(right) {
-		return right
-	}
-
-	if left.Type() != right.Type() {
-		return obj.NewError(
-			"invalid operation %v < %v (mismatched types %v and %v)",
-			left, right, left.Type(), right.Type(),
-		)
-	}
-
-	switch left.Type() {
-	case obj.INT:
-		l := left.(*obj.Integer)
-		r := right.(*obj.Integer)
-		return obj.ParseBool(l.Val() < r.Val())
-
-	case obj.FLOAT:
-		l := left.(*obj.Float)
-		r := right.(*obj.Float)
-		return obj.ParseBool(l.Val() < r.Val())
+// L returns the left operand.
+func (l Less) L() Node {
+	return l.l
+}
 
-	default:
-		return obj.NewError("unsupported operator '<' for type %v", left.Type())
-	}
+// R returns the right operand.
+func (l Less) R() Node {
+	return l.r
 }
 
 func (l Less) String() string {
 	return fmt.Sprintf("(%v < %v)", l.l, l.r)
 }
+
+func (l Less) Pos() Pos {
+	return l.pos
+}
+
+// Compile lowers l into bytecode: it compiles the operands in order and
+// emits the OpLt instruction at l's own position.
+func (l Less) Compile(c *compiler.Compiler) error {
+	if err := l.l.Compile(c); err != nil {
+		return err
+	}
+	if err := l.r.Compile(c); err != nil {
+		return err
+	}
+	c.Emit(l.pos, compiler.OpLt)
+	return nil
+}
@@ -0,0 +1,13 @@
+package ast
+
+import "github.com/NicoNex/tau/compiler"
+
+// Node is implemented by every node in the syntax tree. ast constructs
+// and stringifies trees, and each node also lowers itself into bytecode
+// through Compile (so ast depends on the compiler package). Evaluation is
+// not part of ast: it lives in the eval package.
+type Node interface {
+	String() string
+	Pos() Pos
+	Compile(c *compiler.Compiler) error
+}
@@ -0,0 +1,49 @@
+package ast
+
+import (
+	"fmt"
+
+	"github.com/NicoNex/tau/compiler"
+)
+
+type Plus struct {
+	l   Node
+	r   Node
+	pos Pos
+}
+
+// NewPlus returns a new Plus node, pos being the position of the '+' token.
+func NewPlus(l, r Node, pos Pos) Node {
+	return Plus{l, r, pos}
+}
+
+// L returns the left operand.
+func (p Plus) L() Node {
+	return p.l
+}
+
+// R returns the right operand.
+func (p Plus) R() Node {
+	return p.r
+}
+
+func (p Plus) String() string {
+	return fmt.Sprintf("(%v + %v)", p.l, p.r)
+}
+
+func (p Plus) Pos() Pos {
+	return p.pos
+}
+
+// Compile lowers p into bytecode: it compiles the operands in order and
+// emits the OpAdd instruction at p's own position.
+func (p Plus) Compile(c *compiler.Compiler) error {
+	if err := p.l.Compile(c); err != nil {
+		return err
+	}
+	if err := p.r.Compile(c); err != nil {
+		return err
+	}
+	c.Emit(p.pos, compiler.OpAdd)
+	return nil
+}
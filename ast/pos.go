@@ -0,0 +1,15 @@
+package ast
+
+import "github.com/NicoNex/tau/item"
+
+// Pos identifies a location in the source input. It is carried by every
+// Node so that runtime diagnostics produced during evaluation can point
+// back at the file, line and column the offending construct came from.
+//
+// Position tracking currently only covers the node kinds that exist in
+// this tree (Times, Less, Power, Plus); the many other NewX constructors
+// called from parser/parser.go (NewIdentifier, NewIfExpr, NewFunction,
+// NewIndex, NewAssign, ...) belong to ast node types this snapshot never
+// received, so they still take no position. Give them one, following the
+// same "operator/keyword token" convention, as those node types land.
+type Pos = item.Pos
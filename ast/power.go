@@ -0,0 +1,49 @@
+package ast
+
+import (
+	"fmt"
+
+	"github.com/NicoNex/tau/compiler"
+)
+
+type Power struct {
+	l   Node
+	r   Node
+	pos Pos
+}
+
+// NewPower returns a new Power node, pos being the position of the '**' token.
+func NewPower(l, r Node, pos Pos) Node {
+	return Power{l, r, pos}
+}
+
+// L returns the left operand (the base).
+func (p Power) L() Node {
+	return p.l
+}
+
+// R returns the right operand (the exponent).
+func (p Power) R() Node {
+	return p.r
+}
+
+func (p Power) String() string {
+	return fmt.Sprintf("(%v ** %v)", p.l, p.r)
+}
+
+func (p Power) Pos() Pos {
+	return p.pos
+}
+
+// Compile lowers p into bytecode: it compiles the operands in order and
+// emits the OpPow instruction at p's own position.
+func (p Power) Compile(c *compiler.Compiler) error {
+	if err := p.l.Compile(c); err != nil {
+		return err
+	}
+	if err := p.r.Compile(c); err != nil {
+		return err
+	}
+	c.Emit(p.pos, compiler.OpPow)
+	return nil
+}
@@ -2,52 +2,47 @@ package ast
 
 import (
 	"fmt"
-	"github.com/NicoNex/tau/obj"
+	"github.com/NicoNex/tau/compiler"
 )
 
 type Times struct {
-	l Node
-	r Node
+	l   Node
+	r   Node
+	pos Pos
 }
 
-func NewTimes(l, r Node) Node {
-	return Times{l, r}
+// NewTimes returns a new Times node, pos being the position of the '*' token.
+func NewTimes(l, r Node, pos Pos) Node {
+	return Times{l, r, pos}
 }
 
-func (t Times) Eval(env *obj.Env) obj.Object {
-	var left = t.l.Eval(env)
-	var right = t.r.Eval(env)
-
-	if isError(left) {
-		return left
-	}
-	if isError(right) {
-		return right
-	}
-
-	if left.Type() != right.Type() {
-		return obj.NewError(
-			"invalid operation %v * %v (mismatched types %v and %v)",
-			left, right, left.Type(), right.Type(),
-		)
-	}
-
-	switch left.Type() {
-	case obj.INT:
-		l := left.(*obj.Integer)
-		r := right.(*obj.Integer)
-		return obj.NewInteger(l.Val() * r.Val())
-
-	case obj.FLOAT:
-		l := left.(*obj.Float)
-		r := right.(*obj.Float)
-		return obj.NewFloat(l.Val() * r.Val())
+// L returns the left operand.
+func (t Times) L() Node {
+	return t.l
+}
 
-	default:
-		return obj.NewError("unsupported operator '*' for type %v", left.Type())
-	}
+// R returns the right operand.
+func (t Times) R() Node {
+	return t.r
 }
 
 func (t Times) String() string {
 	return fmt.Sprintf("(%v * %v)", t.l, t.r)
 }
+
+func (t Times) Pos() Pos {
+	return t.pos
+}
+
+// Compile lowers t into bytecode: it compiles the operands in order and
+// emits the OpMul instruction at t's own position.
+func (t Times) Compile(c *compiler.Compiler) error {
+	if err := t.l.Compile(c); err != nil {
+		return err
+	}
+	if err := t.r.Compile(c); err != nil {
+		return err
+	}
+	c.Emit(t.pos, compiler.OpMul)
+	return nil
+}
@@ -0,0 +1,80 @@
+// Command tau runs a tau source file either with the tree-walking
+// evaluator or with the bytecode compiler and VM.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/NicoNex/tau/ast"
+	"github.com/NicoNex/tau/compiler"
+	"github.com/NicoNex/tau/eval"
+	"github.com/NicoNex/tau/obj"
+	"github.com/NicoNex/tau/parser"
+	"github.com/NicoNex/tau/vm"
+)
+
+func main() {
+	useVM := flag.Bool("vm", false, "run the program on the bytecode compiler and VM")
+	useEval := flag.Bool("eval", false, "run the program with the tree-walking evaluator (default)")
+	flag.Parse()
+
+	src, err := readSource(flag.Args())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	prog, errs := parser.Parse(src)
+	if len(errs) != 0 {
+		for _, e := range errs {
+			fmt.Fprintln(os.Stderr, e)
+		}
+		os.Exit(1)
+	}
+
+	if *useVM && !*useEval {
+		run(prog, runVM)
+		return
+	}
+	run(prog, runEval)
+}
+
+func run(prog ast.Node, exec func(ast.Node) (obj.Object, error)) {
+	res, err := exec(prog)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if res != nil {
+		fmt.Println(res)
+	}
+}
+
+func runEval(prog ast.Node) (obj.Object, error) {
+	return eval.New().Eval(prog, obj.NewEnv()), nil
+}
+
+func runVM(prog ast.Node) (obj.Object, error) {
+	c := compiler.New()
+	if err := prog.Compile(c); err != nil {
+		return nil, fmt.Errorf("compile error: %w", err)
+	}
+
+	machine := vm.New(c.Bytecode())
+	if err := machine.Run(); err != nil {
+		return nil, fmt.Errorf("runtime error: %w", err)
+	}
+	return machine.Top(), nil
+}
+
+func readSource(args []string) (string, error) {
+	if len(args) == 0 {
+		b, err := io.ReadAll(os.Stdin)
+		return string(b), err
+	}
+	b, err := os.ReadFile(args[0])
+	return string(b), err
+}
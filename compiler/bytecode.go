@@ -0,0 +1,14 @@
+package compiler
+
+import (
+	"github.com/NicoNex/tau/item"
+	"github.com/NicoNex/tau/obj"
+)
+
+// Bytecode is the flat, linear representation of a program produced by
+// the Compiler, ready to be executed by the vm package.
+type Bytecode struct {
+	Instructions Instructions
+	Constants    []obj.Object
+	SourceMap    []item.Pos
+}
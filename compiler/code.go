@@ -0,0 +1,121 @@
+package compiler
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Instructions is a flat sequence of encoded bytecode instructions.
+type Instructions []byte
+
+// Opcode identifies a single VM instruction.
+type Opcode byte
+
+const (
+	OpConstant Opcode = iota
+	OpAdd
+	OpSub
+	OpMul
+	OpDiv
+	OpPow
+	OpEq
+	OpNotEq
+	OpLt
+	OpGt
+	OpLeq
+	OpGeq
+	OpAnd
+	OpOr
+	OpBang
+	OpMinus
+	OpJump
+	OpJumpNotTruthy
+	OpGetGlobal
+	OpSetGlobal
+	OpGetLocal
+	OpSetLocal
+	OpArray
+	OpIndex
+	OpCall
+	OpReturn
+	OpReturnValue
+	OpClosure
+	OpGetFree
+)
+
+// Definition describes an opcode's mnemonic and the width, in bytes, of
+// each of its operands.
+type Definition struct {
+	Name          string
+	OperandWidths []int
+}
+
+var definitions = map[Opcode]*Definition{
+	OpConstant:      {"OpConstant", []int{2}},
+	OpAdd:           {"OpAdd", []int{}},
+	OpSub:           {"OpSub", []int{}},
+	OpMul:           {"OpMul", []int{}},
+	OpDiv:           {"OpDiv", []int{}},
+	OpPow:           {"OpPow", []int{}},
+	OpEq:            {"OpEq", []int{}},
+	OpNotEq:         {"OpNotEq", []int{}},
+	OpLt:            {"OpLt", []int{}},
+	OpGt:            {"OpGt", []int{}},
+	OpLeq:           {"OpLeq", []int{}},
+	OpGeq:           {"OpGeq", []int{}},
+	OpAnd:           {"OpAnd", []int{}},
+	OpOr:            {"OpOr", []int{}},
+	OpBang:          {"OpBang", []int{}},
+	OpMinus:         {"OpMinus", []int{}},
+	OpJump:          {"OpJump", []int{2}},
+	OpJumpNotTruthy: {"OpJumpNotTruthy", []int{2}},
+	OpGetGlobal:     {"OpGetGlobal", []int{2}},
+	OpSetGlobal:     {"OpSetGlobal", []int{2}},
+	OpGetLocal:      {"OpGetLocal", []int{1}},
+	OpSetLocal:      {"OpSetLocal", []int{1}},
+	OpArray:         {"OpArray", []int{2}},
+	OpIndex:         {"OpIndex", []int{}},
+	OpCall:          {"OpCall", []int{1}},
+	OpReturn:        {"OpReturn", []int{}},
+	OpReturnValue:   {"OpReturnValue", []int{}},
+	OpClosure:       {"OpClosure", []int{2, 1}},
+	OpGetFree:       {"OpGetFree", []int{1}},
+}
+
+// Lookup returns the Definition for op.
+func Lookup(op Opcode) (*Definition, error) {
+	def, ok := definitions[op]
+	if !ok {
+		return nil, fmt.Errorf("opcode %d undefined", op)
+	}
+	return def, nil
+}
+
+// Make encodes op and its operands into a single instruction.
+func Make(op Opcode, operands ...int) []byte {
+	def, ok := definitions[op]
+	if !ok {
+		return []byte{}
+	}
+
+	instructionLen := 1
+	for _, w := range def.OperandWidths {
+		instructionLen += w
+	}
+
+	instruction := make([]byte, instructionLen)
+	instruction[0] = byte(op)
+
+	offset := 1
+	for i, o := range operands {
+		width := def.OperandWidths[i]
+		switch width {
+		case 2:
+			binary.BigEndian.PutUint16(instruction[offset:], uint16(o))
+		case 1:
+			instruction[offset] = byte(o)
+		}
+		offset += width
+	}
+	return instruction
+}
@@ -0,0 +1,53 @@
+package compiler
+
+import (
+	"github.com/NicoNex/tau/item"
+	"github.com/NicoNex/tau/obj"
+)
+
+// Compiler lowers an ast.Node tree into a flat Bytecode program by having
+// each node call Emit/AddConstant on itself (see ast.Node.Compile), mirroring
+// how Eval recurses over the same tree.
+type Compiler struct {
+	instructions Instructions
+	constants    []obj.Object
+	sourceMap    map[int]item.Pos
+}
+
+// New returns a Compiler ready to compile a program.
+func New() *Compiler {
+	return &Compiler{
+		sourceMap: make(map[int]item.Pos),
+	}
+}
+
+// Emit encodes op and operands, appends it to the instruction stream and
+// records pos as the source position for the emitted instruction,
+// returning the offset at which it starts.
+func (c *Compiler) Emit(pos item.Pos, op Opcode, operands ...int) int {
+	ins := Make(op, operands...)
+	offset := len(c.instructions)
+	c.instructions = append(c.instructions, ins...)
+	c.sourceMap[offset] = pos
+	return offset
+}
+
+// AddConstant appends o to the constant pool and returns its index, to be
+// used as the operand of an OpConstant instruction.
+func (c *Compiler) AddConstant(o obj.Object) int {
+	c.constants = append(c.constants, o)
+	return len(c.constants) - 1
+}
+
+// Bytecode returns the compiled program.
+func (c *Compiler) Bytecode() Bytecode {
+	sourceMap := make([]item.Pos, len(c.instructions))
+	for offset, pos := range c.sourceMap {
+		sourceMap[offset] = pos
+	}
+	return Bytecode{
+		Instructions: c.instructions,
+		Constants:    c.constants,
+		SourceMap:    sourceMap,
+	}
+}
@@ -0,0 +1,21 @@
+package eval
+
+import (
+	"github.com/NicoNex/tau/ast"
+	"github.com/NicoNex/tau/obj"
+)
+
+// VisitBlock evaluates each statement of b in order, short-circuiting on
+// the first error, and returns the value of the last statement
+// evaluated (the value of the block itself).
+func (e *Evaluator) VisitBlock(b ast.Block, env *obj.Env) obj.Object {
+	var res obj.Object
+
+	for _, n := range b.Nodes() {
+		res = e.Eval(n, env)
+		if isError(res) {
+			return res
+		}
+	}
+	return res
+}
@@ -0,0 +1,43 @@
+// Package eval implements the tree-walking backend for tau: it evaluates
+// a parsed ast.Node tree directly, as an alternative to the bytecode
+// compiler and VM. Evaluation lives here instead of on the ast nodes
+// themselves; compilation does not, since each ast node carries its own
+// Compile method.
+package eval
+
+import (
+	"github.com/NicoNex/tau/ast"
+	"github.com/NicoNex/tau/obj"
+)
+
+// Evaluator walks an ast.Node tree, dispatching on each node's concrete
+// type, and produces the resulting runtime obj.Object.
+type Evaluator struct{}
+
+// New returns an Evaluator.
+func New() *Evaluator {
+	return &Evaluator{}
+}
+
+// Eval dispatches node to the VisitX method for its concrete type and
+// returns the resulting object.
+func (e *Evaluator) Eval(node ast.Node, env *obj.Env) obj.Object {
+	switch n := node.(type) {
+	case ast.Block:
+		return e.VisitBlock(n, env)
+	case ast.Times:
+		return e.VisitTimes(n, env)
+	case ast.Less:
+		return e.VisitLess(n, env)
+	case ast.Power:
+		return e.VisitPower(n, env)
+	case ast.Plus:
+		return e.VisitPlus(n, env)
+	default:
+		return obj.NewError(ast.Pos{}, "eval: no Visit method for node type %T", node)
+	}
+}
+
+func isError(o obj.Object) bool {
+	return o != nil && o.Type() == obj.ERROR
+}
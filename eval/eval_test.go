@@ -0,0 +1,60 @@
+package eval
+
+import (
+	"testing"
+
+	"github.com/NicoNex/tau/obj"
+	"github.com/NicoNex/tau/parser"
+)
+
+func testEval(t *testing.T, input string) obj.Object {
+	t.Helper()
+	prog, errs := parser.Parse(input)
+	if len(errs) != 0 {
+		t.Fatalf("parser errors for %q: %v", input, errs)
+	}
+	return New().Eval(prog, obj.NewEnv())
+}
+
+// TestNumericCoercion exercises the INT/FLOAT promotion and the
+// string-aware cases of Times and Less for every relevant type pairing.
+func TestNumericCoercion(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"2 * 3", "6"},
+		{"2 * 1.5", "3"},
+		{"1.5 * 2", "3"},
+		{"1.5 * 2.5", "3.75"},
+		{"\"ab\" * 3", "ababab"},
+		{"3 * \"ab\"", "ababab"},
+		{"1 < 2", "true"},
+		{"1 < 2.0", "true"},
+		{"2.0 < 1", "false"},
+		{"\"a\" < \"b\"", "true"},
+		{"\"b\" < \"a\"", "false"},
+		{"1 + 2", "3"},
+		{"1 + 1.5", "2.5"},
+		{"\"foo\" + \"bar\"", "foobar"},
+	}
+
+	for _, tt := range tests {
+		if got := testEval(t, tt.input).String(); got != tt.expected {
+			t.Errorf("%q: expected %q, got %q", tt.input, tt.expected, got)
+		}
+	}
+}
+
+// TestStringRepeatNegativeCount verifies that a negative repeat count
+// yields an obj.Error instead of panicking inside strings.Repeat.
+func TestStringRepeatNegativeCount(t *testing.T) {
+	tests := []string{"\"ab\" * -1", "-1 * \"ab\""}
+
+	for _, input := range tests {
+		got := testEval(t, input)
+		if got.Type() != obj.ERROR {
+			t.Errorf("%q: expected an obj.Error, got %v (%v)", input, got, got.Type())
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package eval
+
+import (
+	"github.com/NicoNex/tau/ast"
+	"github.com/NicoNex/tau/obj"
+)
+
+// VisitLess evaluates an ast.Less node.
+func (e *Evaluator) VisitLess(l ast.Less, env *obj.Env) obj.Object {
+	var left = e.Eval(l.L(), env)
+	var right = e.Eval(l.R(), env)
+
+	if isError(left) {
+		return left
+	}
+	if isError(right) {
+		return right
+	}
+
+	if left.Type() == obj.STRING && right.Type() == obj.STRING {
+		ls := left.(*obj.String)
+		rs := right.(*obj.String)
+		return obj.ParseBool(ls.Val() < rs.Val())
+	}
+
+	left, right, err := obj.CoerceNumeric(left, right)
+	if err != nil {
+		return obj.NewError(l.Pos(), "%v", err)
+	}
+
+	switch left.Type() {
+	case obj.INT:
+		li := left.(*obj.Integer)
+		ri := right.(*obj.Integer)
+		return obj.ParseBool(li.Val() < ri.Val())
+
+	case obj.FLOAT:
+		lf := left.(*obj.Float)
+		rf := right.(*obj.Float)
+		return obj.ParseBool(lf.Val() < rf.Val())
+
+	default:
+		return obj.NewError(l.Pos(), "unsupported operator '<' for type %v", left.Type())
+	}
+}
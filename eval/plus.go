@@ -0,0 +1,45 @@
+package eval
+
+import (
+	"github.com/NicoNex/tau/ast"
+	"github.com/NicoNex/tau/obj"
+)
+
+// VisitPlus evaluates an ast.Plus node.
+func (e *Evaluator) VisitPlus(p ast.Plus, env *obj.Env) obj.Object {
+	var left = e.Eval(p.L(), env)
+	var right = e.Eval(p.R(), env)
+
+	if isError(left) {
+		return left
+	}
+	if isError(right) {
+		return right
+	}
+
+	if left.Type() == obj.STRING && right.Type() == obj.STRING {
+		l := left.(*obj.String)
+		r := right.(*obj.String)
+		return obj.NewString(l.Val() + r.Val())
+	}
+
+	left, right, err := obj.CoerceNumeric(left, right)
+	if err != nil {
+		return obj.NewError(p.Pos(), "%v", err)
+	}
+
+	switch left.Type() {
+	case obj.INT:
+		l := left.(*obj.Integer)
+		r := right.(*obj.Integer)
+		return obj.NewInteger(l.Val() + r.Val())
+
+	case obj.FLOAT:
+		l := left.(*obj.Float)
+		r := right.(*obj.Float)
+		return obj.NewFloat(l.Val() + r.Val())
+
+	default:
+		return obj.NewError(p.Pos(), "unsupported operator '+' for type %v", left.Type())
+	}
+}
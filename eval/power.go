@@ -0,0 +1,50 @@
+package eval
+
+import (
+	"math"
+
+	"github.com/NicoNex/tau/ast"
+	"github.com/NicoNex/tau/obj"
+)
+
+// VisitPower evaluates an ast.Power node.
+func (e *Evaluator) VisitPower(p ast.Power, env *obj.Env) obj.Object {
+	var left = e.Eval(p.L(), env)
+	var right = e.Eval(p.R(), env)
+
+	if isError(left) {
+		return left
+	}
+	if isError(right) {
+		return right
+	}
+
+	if left.Type() != right.Type() {
+		return obj.NewError(
+			p.Pos(),
+			"invalid operation %v ** %v (mismatched types %v and %v)",
+			left, right, left.Type(), right.Type(),
+		)
+	}
+
+	switch left.Type() {
+	case obj.INT:
+		l := left.(*obj.Integer)
+		r := right.(*obj.Integer)
+
+		// A negative exponent can't be represented as an integer, so the
+		// result is promoted to FLOAT, same as `r < 0` in Python's `**`.
+		if r.Val() < 0 {
+			return obj.NewFloat(math.Pow(float64(l.Val()), float64(r.Val())))
+		}
+		return obj.NewInteger(int64(math.Pow(float64(l.Val()), float64(r.Val()))))
+
+	case obj.FLOAT:
+		l := left.(*obj.Float)
+		r := right.(*obj.Float)
+		return obj.NewFloat(math.Pow(l.Val(), r.Val()))
+
+	default:
+		return obj.NewError(p.Pos(), "unsupported operator '**' for type %v", left.Type())
+	}
+}
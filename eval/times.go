@@ -0,0 +1,48 @@
+package eval
+
+import (
+	"strings"
+
+	"github.com/NicoNex/tau/ast"
+	"github.com/NicoNex/tau/obj"
+)
+
+// VisitTimes evaluates an ast.Times node.
+func (e *Evaluator) VisitTimes(t ast.Times, env *obj.Env) obj.Object {
+	var left = e.Eval(t.L(), env)
+	var right = e.Eval(t.R(), env)
+
+	if isError(left) {
+		return left
+	}
+	if isError(right) {
+		return right
+	}
+
+	if s, n, ok := obj.StringRepeatOperands(left, right); ok {
+		if n < 0 {
+			return obj.NewError(t.Pos(), "invalid repeat count %d for string repetition", n)
+		}
+		return obj.NewString(strings.Repeat(s.Val(), n))
+	}
+
+	left, right, err := obj.CoerceNumeric(left, right)
+	if err != nil {
+		return obj.NewError(t.Pos(), "%v", err)
+	}
+
+	switch left.Type() {
+	case obj.INT:
+		l := left.(*obj.Integer)
+		r := right.(*obj.Integer)
+		return obj.NewInteger(l.Val() * r.Val())
+
+	case obj.FLOAT:
+		l := left.(*obj.Float)
+		r := right.(*obj.Float)
+		return obj.NewFloat(l.Val() * r.Val())
+
+	default:
+		return obj.NewError(t.Pos(), "unsupported operator '*' for type %v", left.Type())
+	}
+}
@@ -0,0 +1,32 @@
+package obj
+
+import (
+	"strings"
+)
+
+// Array is the runtime representation of an ARRAY value.
+type Array struct {
+	elements []Object
+}
+
+// NewArray returns an Array wrapping elements.
+func NewArray(elements []Object) Object {
+	return &Array{elements: elements}
+}
+
+// Elements returns the array's elements.
+func (a *Array) Elements() []Object {
+	return a.elements
+}
+
+func (a *Array) Type() ObjectType {
+	return ARRAY
+}
+
+func (a *Array) String() string {
+	parts := make([]string, len(a.elements))
+	for i, e := range a.elements {
+		parts[i] = e.String()
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
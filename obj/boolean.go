@@ -0,0 +1,36 @@
+package obj
+
+// Boolean is the runtime representation of a BOOLEAN value. True and
+// False are the only two instances that ever exist.
+type Boolean struct {
+	val bool
+}
+
+var (
+	True  = &Boolean{val: true}
+	False = &Boolean{val: false}
+)
+
+// ParseBool returns the shared True or False instance for b.
+func ParseBool(b bool) Object {
+	if b {
+		return True
+	}
+	return False
+}
+
+// Val returns the underlying bool.
+func (b *Boolean) Val() bool {
+	return b.val
+}
+
+func (b *Boolean) Type() ObjectType {
+	return BOOLEAN
+}
+
+func (b *Boolean) String() string {
+	if b.val {
+		return "true"
+	}
+	return "false"
+}
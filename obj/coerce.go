@@ -0,0 +1,33 @@
+package obj
+
+import "fmt"
+
+// CoerceNumeric applies tau's INT/FLOAT promotion rule for binary
+// operators: if one operand is INT and the other FLOAT, the INT operand
+// is promoted to FLOAT so both operands end up the same numeric type. If
+// neither operand is numeric, or the two are otherwise incompatible, it
+// returns an error describing the mismatched types. This is the single
+// implementation shared by every ast/eval/vm code path that needs
+// numeric promotion, so `2 * 1.5`, `1 < 2.0`, etc. behave identically
+// whichever binary node or backend evaluates them.
+func CoerceNumeric(a, b Object) (Object, Object, error) {
+	switch {
+	case a.Type() == INT && b.Type() == INT:
+		return a, b, nil
+
+	case a.Type() == FLOAT && b.Type() == FLOAT:
+		return a, b, nil
+
+	case a.Type() == INT && b.Type() == FLOAT:
+		return NewFloat(float64(a.(*Integer).Val())), b, nil
+
+	case a.Type() == FLOAT && b.Type() == INT:
+		return a, NewFloat(float64(b.(*Integer).Val())), nil
+
+	default:
+		return nil, nil, fmt.Errorf(
+			"invalid operation %v and %v (mismatched types %v and %v)",
+			a, b, a.Type(), b.Type(),
+		)
+	}
+}
@@ -0,0 +1,60 @@
+package obj
+
+import "testing"
+
+// TestCoerceNumeric covers every pairing of the four primitive types:
+// the two numeric pairings that promote INT to FLOAT, the two pure-INT
+// and pure-FLOAT pairings that pass through unchanged, and every
+// pairing involving a non-numeric type, which must error.
+func TestCoerceNumeric(t *testing.T) {
+	num := func(o Object) string { return o.String() }
+
+	tests := []struct {
+		name      string
+		a, b      Object
+		wantErr   bool
+		wantA     string
+		wantB     string
+		wantAType ObjectType
+		wantBType ObjectType
+	}{
+		{name: "int,int", a: NewInteger(2), b: NewInteger(3), wantA: "2", wantB: "3", wantAType: INT, wantBType: INT},
+		{name: "float,float", a: NewFloat(1.5), b: NewFloat(2.5), wantA: "1.5", wantB: "2.5", wantAType: FLOAT, wantBType: FLOAT},
+		{name: "int,float", a: NewInteger(2), b: NewFloat(1.5), wantA: "2", wantB: "1.5", wantAType: FLOAT, wantBType: FLOAT},
+		{name: "float,int", a: NewFloat(1.5), b: NewInteger(2), wantA: "1.5", wantB: "2", wantAType: FLOAT, wantBType: FLOAT},
+		{name: "int,string", a: NewInteger(2), b: NewString("x"), wantErr: true},
+		{name: "string,int", a: NewString("x"), b: NewInteger(2), wantErr: true},
+		{name: "float,string", a: NewFloat(1.5), b: NewString("x"), wantErr: true},
+		{name: "string,float", a: NewString("x"), b: NewFloat(1.5), wantErr: true},
+		{name: "string,string", a: NewString("x"), b: NewString("y"), wantErr: true},
+		{name: "bool,int", a: ParseBool(true), b: NewInteger(2), wantErr: true},
+		{name: "int,bool", a: NewInteger(2), b: ParseBool(true), wantErr: true},
+		{name: "bool,float", a: ParseBool(true), b: NewFloat(1.5), wantErr: true},
+		{name: "float,bool", a: NewFloat(1.5), b: ParseBool(true), wantErr: true},
+		{name: "bool,bool", a: ParseBool(true), b: ParseBool(false), wantErr: true},
+		{name: "bool,string", a: ParseBool(true), b: NewString("x"), wantErr: true},
+		{name: "string,bool", a: NewString("x"), b: ParseBool(true), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b, err := CoerceNumeric(tt.a, tt.b)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got a=%v b=%v", a, b)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if a.Type() != tt.wantAType || b.Type() != tt.wantBType {
+				t.Fatalf("expected types %v/%v, got %v/%v", tt.wantAType, tt.wantBType, a.Type(), b.Type())
+			}
+			if num(a) != tt.wantA || num(b) != tt.wantB {
+				t.Errorf("expected a=%v b=%v, got a=%v b=%v", tt.wantA, tt.wantB, num(a), num(b))
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package obj
+
+// Env binds identifiers to values for a single lexical scope, falling
+// back to an enclosing scope when a name isn't found locally.
+type Env struct {
+	store map[string]Object
+	outer *Env
+}
+
+// NewEnv returns an empty, top-level Env.
+func NewEnv() *Env {
+	return &Env{store: make(map[string]Object)}
+}
+
+// NewEnclosedEnv returns an Env nested inside outer.
+func NewEnclosedEnv(outer *Env) *Env {
+	env := NewEnv()
+	env.outer = outer
+	return env
+}
+
+// Get looks up name in env, falling back to outer scopes.
+func (e *Env) Get(name string) (Object, bool) {
+	val, ok := e.store[name]
+	if !ok && e.outer != nil {
+		return e.outer.Get(name)
+	}
+	return val, ok
+}
+
+// Set binds name to val in env and returns val.
+func (e *Env) Set(name string, val Object) Object {
+	e.store[name] = val
+	return val
+}
@@ -0,0 +1,28 @@
+package obj
+
+import (
+	"fmt"
+
+	"github.com/NicoNex/tau/item"
+)
+
+// Error is the runtime representation of a failed operation. It carries
+// the source position of the offending construct so diagnostics can
+// point back at file:line:col instead of just the message.
+type Error struct {
+	pos item.Pos
+	msg string
+}
+
+// NewError formats format/args into an Error positioned at pos.
+func NewError(pos item.Pos, format string, args ...interface{}) Object {
+	return &Error{pos: pos, msg: fmt.Sprintf(format, args...)}
+}
+
+func (e *Error) Type() ObjectType {
+	return ERROR
+}
+
+func (e *Error) String() string {
+	return fmt.Sprintf("%v: %s", e.pos, e.msg)
+}
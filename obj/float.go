@@ -0,0 +1,26 @@
+package obj
+
+import "strconv"
+
+// Float is the runtime representation of a FLOAT value.
+type Float struct {
+	val float64
+}
+
+// NewFloat returns a Float wrapping v.
+func NewFloat(v float64) Object {
+	return &Float{val: v}
+}
+
+// Val returns the underlying float64.
+func (f *Float) Val() float64 {
+	return f.val
+}
+
+func (f *Float) Type() ObjectType {
+	return FLOAT
+}
+
+func (f *Float) String() string {
+	return strconv.FormatFloat(f.val, 'f', -1, 64)
+}
@@ -0,0 +1,26 @@
+package obj
+
+import "strconv"
+
+// Integer is the runtime representation of an INT value.
+type Integer struct {
+	val int64
+}
+
+// NewInteger returns an Integer wrapping v.
+func NewInteger(v int64) Object {
+	return &Integer{val: v}
+}
+
+// Val returns the underlying int64.
+func (i *Integer) Val() int64 {
+	return i.val
+}
+
+func (i *Integer) Type() ObjectType {
+	return INT
+}
+
+func (i *Integer) String() string {
+	return strconv.FormatInt(i.val, 10)
+}
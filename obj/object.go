@@ -0,0 +1,42 @@
+// Package obj implements tau's runtime values: the Object interface and
+// its concrete types (Integer, Float, String, Boolean, Array, Error), the
+// variable environment Eval operates over, and the numeric
+// coercion shared by every binary operator.
+package obj
+
+// ObjectType identifies the concrete kind of an Object.
+type ObjectType int
+
+const (
+	INT ObjectType = iota
+	FLOAT
+	STRING
+	BOOLEAN
+	ARRAY
+	ERROR
+)
+
+func (t ObjectType) String() string {
+	switch t {
+	case INT:
+		return "INTEGER"
+	case FLOAT:
+		return "FLOAT"
+	case STRING:
+		return "STRING"
+	case BOOLEAN:
+		return "BOOLEAN"
+	case ARRAY:
+		return "ARRAY"
+	case ERROR:
+		return "ERROR"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// Object is implemented by every tau runtime value.
+type Object interface {
+	Type() ObjectType
+	String() string
+}
@@ -0,0 +1,20 @@
+package obj
+
+// StringRepeatOperands reports whether left/right form a `string * int` or
+// `int * string` repetition and, if so, returns the string operand and the
+// repeat count. It performs no validation of the count itself; callers are
+// responsible for rejecting a negative count with a position-aware error,
+// since only they have the position to attach to it.
+func StringRepeatOperands(left, right Object) (*String, int, bool) {
+	if s, ok := left.(*String); ok {
+		if n, ok := right.(*Integer); ok {
+			return s, int(n.Val()), true
+		}
+	}
+	if s, ok := right.(*String); ok {
+		if n, ok := left.(*Integer); ok {
+			return s, int(n.Val()), true
+		}
+	}
+	return nil, 0, false
+}
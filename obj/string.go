@@ -0,0 +1,24 @@
+package obj
+
+// String is the runtime representation of a STRING value.
+type String struct {
+	val string
+}
+
+// NewString returns a String wrapping v.
+func NewString(v string) Object {
+	return &String{val: v}
+}
+
+// Val returns the underlying string.
+func (s *String) Val() string {
+	return s.val
+}
+
+func (s *String) Type() ObjectType {
+	return STRING
+}
+
+func (s *String) String() string {
+	return s.val
+}
@@ -31,6 +31,7 @@ const (
 	LESSGREATER
 	SUM
 	PRODUCT
+	POWER
 	PREFIX
 	CALL
 	INDEX
@@ -49,8 +50,8 @@ var precedences = map[item.Type]int{
 	item.OR:              SUM,
 	item.SLASH:           PRODUCT,
 	item.ASTERISK:        PRODUCT,
-	item.POWER:           PRODUCT,
 	item.AND:             PRODUCT,
+	item.POWER:           POWER,
 	item.LPAREN:          CALL,
 	item.LBRACKET:        INDEX,
 	item.ASSIGN:          ASSIGNMENT,
@@ -93,7 +94,7 @@ func newParser(items chan item.Item) *Parser {
 	p.registerInfix(item.MINUS, p.parseMinus)
 	p.registerInfix(item.SLASH, p.parseSlash)
 	p.registerInfix(item.ASTERISK, p.parseAsterisk)
-	// p.registerInfix(item.POWER, p.parseInfixExpression)
+	p.registerInfix(item.POWER, p.parsePower)
 	p.registerInfix(item.ASSIGN, p.parseAssign)
 	p.registerInfix(item.PLUS_ASSIGN, p.parsePlusAssign)
 	p.registerInfix(item.MINUS_ASSIGN, p.parseMinusAssign)
@@ -307,9 +308,10 @@ func (p *Parser) parseBang() ast.Node {
 }
 
 func (p *Parser) parsePlus(left ast.Node) ast.Node {
+	pos := p.cur.Pos()
 	prec := p.precedence()
 	p.next()
-	return ast.NewPlus(left, p.parseExpr(prec))
+	return ast.NewPlus(left, p.parseExpr(prec), pos)
 }
 
 func (p *Parser) parseMinus(left ast.Node) ast.Node {
@@ -319,9 +321,23 @@ func (p *Parser) parseMinus(left ast.Node) ast.Node {
 }
 
 func (p *Parser) parseAsterisk(left ast.Node) ast.Node {
+	pos := p.cur.Pos()
 	prec := p.precedence()
 	p.next()
-	return ast.NewTimes(left, p.parseExpr(prec))
+	return ast.NewTimes(left, p.parseExpr(prec), pos)
+}
+
+// parsePower returns a node of type ast.Power. '**' is right-associative,
+// so unlike the other infix parsers it recurses with prec-1: parsing
+// a ** b ** c must yield a ** (b ** c) rather than (a ** b) ** c.
+//
+// POWER sits between PRODUCT and PREFIX, so prefix minus binds tighter
+// than '**': -2 ** 2 parses as (-2) ** 2, not -(2 ** 2).
+func (p *Parser) parsePower(left ast.Node) ast.Node {
+	pos := p.cur.Pos()
+	prec := p.precedence()
+	p.next()
+	return ast.NewPower(left, p.parseExpr(prec-1), pos)
 }
 
 func (p *Parser) parseSlash(left ast.Node) ast.Node {
@@ -345,9 +361,10 @@ func (p *Parser) parseNotEquals(left ast.Node) ast.Node {
 }
 
 func (p *Parser) parseLess(left ast.Node) ast.Node {
+	pos := p.cur.Pos()
 	prec := p.precedence()
 	p.next()
-	return ast.NewLess(left, p.parseExpr(prec))
+	return ast.NewLess(left, p.parseExpr(prec), pos)
 }
 
 func (p *Parser) parseGreater(left ast.Node) ast.Node {
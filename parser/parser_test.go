@@ -0,0 +1,88 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/NicoNex/tau/ast"
+)
+
+// TestNodePositions verifies that each node records the position of the
+// token most characteristic of its production, as described in the
+// "left-most token uniquely associated with the production" convention.
+func TestNodePositions(t *testing.T) {
+	tests := []struct {
+		input string
+		line  int
+		col   int
+	}{
+		{"1 * 2", 1, 3},
+		{"1 < 2", 1, 3},
+		// Top-level node is Plus (SUM binds looser than PRODUCT), whose
+		// pos is the '+' token, not the '*' nested under its right operand.
+		{"1 + 2 * 3", 1, 3},
+		{"2 ** 3", 1, 3},
+	}
+
+	for _, tt := range tests {
+		prog, errs := Parse(tt.input)
+		if len(errs) != 0 {
+			t.Fatalf("parser errors for %q: %v", tt.input, errs)
+		}
+
+		block, ok := prog.(ast.Block)
+		if !ok {
+			t.Fatalf("prog is not ast.Block, got %T", prog)
+		}
+		if len(block.Nodes()) != 1 {
+			t.Fatalf("expected 1 statement, got %d", len(block.Nodes()))
+		}
+
+		node := block.Nodes()[0]
+		poser, ok := node.(interface{ Pos() ast.Pos })
+		if !ok {
+			t.Fatalf("%q: node %T does not expose Pos()", tt.input, node)
+		}
+
+		pos := poser.Pos()
+		if pos.Line != tt.line || pos.Column != tt.col {
+			t.Errorf(
+				"%q: expected pos %d:%d, got %d:%d",
+				tt.input, tt.line, tt.col, pos.Line, pos.Column,
+			)
+		}
+	}
+}
+
+// TestPowerPrecedence asserts that '**' parses at all (it used to be
+// registered with no infix parser), that it is right-associative, and
+// that it binds looser than prefix minus.
+func TestPowerPrecedence(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"2 ** 3", "(2 ** 3)"},
+		{"2 ** 3 ** 2", "(2 ** (3 ** 2))"},
+		{"-2 ** 2", "((-2) ** 2)"},
+		{"2 * 3 ** 2", "(2 * (3 ** 2))"},
+	}
+
+	for _, tt := range tests {
+		prog, errs := Parse(tt.input)
+		if len(errs) != 0 {
+			t.Fatalf("parser errors for %q: %v", tt.input, errs)
+		}
+
+		block, ok := prog.(ast.Block)
+		if !ok {
+			t.Fatalf("prog is not ast.Block, got %T", prog)
+		}
+		if len(block.Nodes()) != 1 {
+			t.Fatalf("expected 1 statement, got %d", len(block.Nodes()))
+		}
+
+		if got := block.Nodes()[0].String(); got != tt.expected {
+			t.Errorf("%q: expected %q, got %q", tt.input, tt.expected, got)
+		}
+	}
+}
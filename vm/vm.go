@@ -0,0 +1,502 @@
+package vm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/NicoNex/tau/compiler"
+	"github.com/NicoNex/tau/item"
+	"github.com/NicoNex/tau/obj"
+)
+
+// StackSize is the maximum depth of the VM's value stack.
+const StackSize = 2048
+
+// GlobalsSize is the maximum number of global bindings the VM supports.
+const GlobalsSize = 65536
+
+// VM executes the Bytecode produced by the compiler package on a value
+// stack, as an alternative to walking the AST with Eval.
+type VM struct {
+	constants    []obj.Object
+	instructions compiler.Instructions
+	sourceMap    []item.Pos
+
+	stack   []obj.Object
+	sp      int
+	globals []obj.Object
+}
+
+// New returns a VM ready to run bc.
+func New(bc compiler.Bytecode) *VM {
+	return &VM{
+		constants:    bc.Constants,
+		instructions: bc.Instructions,
+		sourceMap:    bc.SourceMap,
+		stack:        make([]obj.Object, StackSize),
+		globals:      make([]obj.Object, GlobalsSize),
+	}
+}
+
+// SourcePos returns the source position of the instruction at ip, or the
+// zero item.Pos if ip has no recorded position.
+func (vm *VM) SourcePos(ip int) item.Pos {
+	if ip < 0 || ip >= len(vm.sourceMap) {
+		return item.Pos{}
+	}
+	return vm.sourceMap[ip]
+}
+
+// Top returns the object on top of the stack without popping it, or nil
+// if the stack is empty.
+func (vm *VM) Top() obj.Object {
+	if vm.sp == 0 {
+		return nil
+	}
+	return vm.stack[vm.sp-1]
+}
+
+// Run executes the VM's instructions to completion.
+func (vm *VM) Run() error {
+	for ip := 0; ip < len(vm.instructions); ip++ {
+		op := compiler.Opcode(vm.instructions[ip])
+
+		switch op {
+		case compiler.OpConstant:
+			idx := int(binary.BigEndian.Uint16(vm.instructions[ip+1:]))
+			ip += 2
+			if err := vm.push(vm.constants[idx]); err != nil {
+				return err
+			}
+
+		case compiler.OpAdd:
+			if err := vm.execAdd(ip); err != nil {
+				return err
+			}
+
+		case compiler.OpSub:
+			if err := vm.execNumeric(ip, "-", func(a, b int64) int64 { return a - b }, func(a, b float64) float64 { return a - b }); err != nil {
+				return err
+			}
+
+		case compiler.OpMul:
+			if err := vm.execMul(ip); err != nil {
+				return err
+			}
+
+		case compiler.OpDiv:
+			if err := vm.execNumeric(ip, "/", func(a, b int64) int64 { return a / b }, func(a, b float64) float64 { return a / b }); err != nil {
+				return err
+			}
+
+		case compiler.OpPow:
+			if err := vm.execPowerOp(ip); err != nil {
+				return err
+			}
+
+		case compiler.OpEq:
+			if err := vm.execEquality(ip, true); err != nil {
+				return err
+			}
+
+		case compiler.OpNotEq:
+			if err := vm.execEquality(ip, false); err != nil {
+				return err
+			}
+
+		case compiler.OpLt:
+			if err := vm.execLt(ip); err != nil {
+				return err
+			}
+
+		case compiler.OpGt:
+			if err := vm.execComparison(ip, ">", func(a, b int64) bool { return a > b }, func(a, b float64) bool { return a > b }, func(a, b string) bool { return a > b }); err != nil {
+				return err
+			}
+
+		case compiler.OpLeq:
+			if err := vm.execComparison(ip, "<=", func(a, b int64) bool { return a <= b }, func(a, b float64) bool { return a <= b }, func(a, b string) bool { return a <= b }); err != nil {
+				return err
+			}
+
+		case compiler.OpGeq:
+			if err := vm.execComparison(ip, ">=", func(a, b int64) bool { return a >= b }, func(a, b float64) bool { return a >= b }, func(a, b string) bool { return a >= b }); err != nil {
+				return err
+			}
+
+		case compiler.OpAnd:
+			right, left := vm.pop(), vm.pop()
+			if err := vm.push(obj.ParseBool(isTruthy(left) && isTruthy(right))); err != nil {
+				return err
+			}
+
+		case compiler.OpOr:
+			right, left := vm.pop(), vm.pop()
+			if err := vm.push(obj.ParseBool(isTruthy(left) || isTruthy(right))); err != nil {
+				return err
+			}
+
+		case compiler.OpBang:
+			if err := vm.push(obj.ParseBool(!isTruthy(vm.pop()))); err != nil {
+				return err
+			}
+
+		case compiler.OpMinus:
+			if err := vm.execUnaryMinus(ip); err != nil {
+				return err
+			}
+
+		case compiler.OpJump:
+			target := int(binary.BigEndian.Uint16(vm.instructions[ip+1:]))
+			ip = target - 1
+
+		case compiler.OpJumpNotTruthy:
+			target := int(binary.BigEndian.Uint16(vm.instructions[ip+1:]))
+			ip += 2
+			if !isTruthy(vm.pop()) {
+				ip = target - 1
+			}
+
+		case compiler.OpGetGlobal:
+			idx := int(binary.BigEndian.Uint16(vm.instructions[ip+1:]))
+			ip += 2
+			if err := vm.push(vm.globals[idx]); err != nil {
+				return err
+			}
+
+		case compiler.OpSetGlobal:
+			idx := int(binary.BigEndian.Uint16(vm.instructions[ip+1:]))
+			ip += 2
+			vm.globals[idx] = vm.pop()
+
+		case compiler.OpArray:
+			n := int(binary.BigEndian.Uint16(vm.instructions[ip+1:]))
+			ip += 2
+			elements := make([]obj.Object, n)
+			for i := n - 1; i >= 0; i-- {
+				elements[i] = vm.pop()
+			}
+			if err := vm.push(obj.NewArray(elements)); err != nil {
+				return err
+			}
+
+		case compiler.OpIndex:
+			if err := vm.execIndex(ip); err != nil {
+				return err
+			}
+
+		// OpGetLocal, OpSetLocal, OpCall, OpReturn, OpReturnValue, OpClosure
+		// and OpGetFree need call frames (a return-address/base-pointer
+		// stack) keyed to compiled functions and closures. Nothing in this
+		// tree compiles those yet (there's no ast.Function/ast.Call here),
+		// so there's no way to exercise or validate that machinery honestly
+		// yet; they're deferred to land together with function support.
+		default:
+			return fmt.Errorf("%v: unknown opcode %d", vm.SourcePos(ip), op)
+		}
+	}
+	return nil
+}
+
+// execAdd implements OpAdd: string concatenation when both operands are
+// STRING, numeric addition (with INT/FLOAT promotion) otherwise. Mirrors
+// eval.VisitPlus so -eval and -vm agree on '+'.
+func (vm *VM) execAdd(ip int) error {
+	right := vm.pop()
+	left := vm.pop()
+	pos := vm.SourcePos(ip)
+
+	if left.Type() == obj.STRING && right.Type() == obj.STRING {
+		l := left.(*obj.String)
+		r := right.(*obj.String)
+		return vm.push(obj.NewString(l.Val() + r.Val()))
+	}
+
+	left, right, err := obj.CoerceNumeric(left, right)
+	if err != nil {
+		return vm.push(obj.NewError(pos, "%v", err))
+	}
+
+	switch left.Type() {
+	case obj.INT:
+		l := left.(*obj.Integer)
+		r := right.(*obj.Integer)
+		return vm.push(obj.NewInteger(l.Val() + r.Val()))
+
+	case obj.FLOAT:
+		l := left.(*obj.Float)
+		r := right.(*obj.Float)
+		return vm.push(obj.NewFloat(l.Val() + r.Val()))
+
+	default:
+		return vm.push(obj.NewError(pos, "unsupported operator '+' for type %v", left.Type()))
+	}
+}
+
+// execMul implements OpMul: string * int / int * string repetition,
+// numeric multiplication (with INT/FLOAT promotion) otherwise. Mirrors
+// eval.VisitTimes so -eval and -vm agree on '*'.
+func (vm *VM) execMul(ip int) error {
+	right := vm.pop()
+	left := vm.pop()
+	pos := vm.SourcePos(ip)
+
+	if s, n, ok := obj.StringRepeatOperands(left, right); ok {
+		if n < 0 {
+			return vm.push(obj.NewError(pos, "invalid repeat count %d for string repetition", n))
+		}
+		return vm.push(obj.NewString(strings.Repeat(s.Val(), n)))
+	}
+
+	left, right, err := obj.CoerceNumeric(left, right)
+	if err != nil {
+		return vm.push(obj.NewError(pos, "%v", err))
+	}
+
+	switch left.Type() {
+	case obj.INT:
+		l := left.(*obj.Integer)
+		r := right.(*obj.Integer)
+		return vm.push(obj.NewInteger(l.Val() * r.Val()))
+
+	case obj.FLOAT:
+		l := left.(*obj.Float)
+		r := right.(*obj.Float)
+		return vm.push(obj.NewFloat(l.Val() * r.Val()))
+
+	default:
+		return vm.push(obj.NewError(pos, "unsupported operator '*' for type %v", left.Type()))
+	}
+}
+
+// execLt implements OpLt: lexicographic comparison when both operands are
+// STRING, numeric comparison (with INT/FLOAT promotion) otherwise.
+// Mirrors eval.VisitLess so -eval and -vm agree on '<'.
+func (vm *VM) execLt(ip int) error {
+	right := vm.pop()
+	left := vm.pop()
+	pos := vm.SourcePos(ip)
+
+	if left.Type() == obj.STRING && right.Type() == obj.STRING {
+		l := left.(*obj.String)
+		r := right.(*obj.String)
+		return vm.push(obj.ParseBool(l.Val() < r.Val()))
+	}
+
+	left, right, err := obj.CoerceNumeric(left, right)
+	if err != nil {
+		return vm.push(obj.NewError(pos, "%v", err))
+	}
+
+	switch left.Type() {
+	case obj.INT:
+		l := left.(*obj.Integer)
+		r := right.(*obj.Integer)
+		return vm.push(obj.ParseBool(l.Val() < r.Val()))
+
+	case obj.FLOAT:
+		l := left.(*obj.Float)
+		r := right.(*obj.Float)
+		return vm.push(obj.ParseBool(l.Val() < r.Val()))
+
+	default:
+		return vm.push(obj.NewError(pos, "unsupported operator '<' for type %v", left.Type()))
+	}
+}
+
+func (vm *VM) execPowerOp(ip int) error {
+	right := vm.pop()
+	left := vm.pop()
+	pos := vm.SourcePos(ip)
+
+	if left.Type() != right.Type() {
+		return vm.push(obj.NewError(
+			pos,
+			"invalid operation %v ** %v (mismatched types %v and %v)",
+			left, right, left.Type(), right.Type(),
+		))
+	}
+
+	switch left.Type() {
+	case obj.INT:
+		l := left.(*obj.Integer)
+		r := right.(*obj.Integer)
+		if r.Val() < 0 {
+			return vm.push(obj.NewFloat(math.Pow(float64(l.Val()), float64(r.Val()))))
+		}
+		return vm.push(obj.NewInteger(int64(math.Pow(float64(l.Val()), float64(r.Val())))))
+
+	case obj.FLOAT:
+		l := left.(*obj.Float)
+		r := right.(*obj.Float)
+		return vm.push(obj.NewFloat(math.Pow(l.Val(), r.Val())))
+
+	default:
+		return vm.push(obj.NewError(pos, "unsupported operator '**' for type %v", left.Type()))
+	}
+}
+
+// execNumeric implements the INT/FLOAT-promoting arithmetic ops '-' and
+// '/' ('+' and '*' also need string handling: see execAdd/execMul).
+func (vm *VM) execNumeric(ip int, symbol string, intOp func(a, b int64) int64, floatOp func(a, b float64) float64) error {
+	right := vm.pop()
+	left := vm.pop()
+	pos := vm.SourcePos(ip)
+
+	left, right, err := obj.CoerceNumeric(left, right)
+	if err != nil {
+		return vm.push(obj.NewError(pos, "%v", err))
+	}
+
+	switch left.Type() {
+	case obj.INT:
+		l := left.(*obj.Integer)
+		r := right.(*obj.Integer)
+		return vm.push(obj.NewInteger(intOp(l.Val(), r.Val())))
+
+	case obj.FLOAT:
+		l := left.(*obj.Float)
+		r := right.(*obj.Float)
+		return vm.push(obj.NewFloat(floatOp(l.Val(), r.Val())))
+
+	default:
+		return vm.push(obj.NewError(pos, "unsupported operator '%s' for type %v", symbol, left.Type()))
+	}
+}
+
+// execComparison implements OpGt/OpLeq/OpGeq: lexicographic comparison for
+// STRING, numeric comparison (with INT/FLOAT promotion) otherwise.
+func (vm *VM) execComparison(ip int, symbol string, intOp func(a, b int64) bool, floatOp func(a, b float64) bool, strOp func(a, b string) bool) error {
+	right := vm.pop()
+	left := vm.pop()
+	pos := vm.SourcePos(ip)
+
+	if left.Type() == obj.STRING && right.Type() == obj.STRING {
+		l := left.(*obj.String)
+		r := right.(*obj.String)
+		return vm.push(obj.ParseBool(strOp(l.Val(), r.Val())))
+	}
+
+	left, right, err := obj.CoerceNumeric(left, right)
+	if err != nil {
+		return vm.push(obj.NewError(pos, "%v", err))
+	}
+
+	switch left.Type() {
+	case obj.INT:
+		l := left.(*obj.Integer)
+		r := right.(*obj.Integer)
+		return vm.push(obj.ParseBool(intOp(l.Val(), r.Val())))
+
+	case obj.FLOAT:
+		l := left.(*obj.Float)
+		r := right.(*obj.Float)
+		return vm.push(obj.ParseBool(floatOp(l.Val(), r.Val())))
+
+	default:
+		return vm.push(obj.NewError(pos, "unsupported operator '%s' for type %v", symbol, left.Type()))
+	}
+}
+
+// execEquality implements OpEq/OpNotEq. INT/FLOAT operands are compared
+// after numeric promotion so 1 == 1.0 holds; every other type pairing
+// compares equal only when both type and underlying value match.
+func (vm *VM) execEquality(ip int, wantEqual bool) error {
+	right := vm.pop()
+	left := vm.pop()
+
+	equal := objectsEqual(left, right)
+	if !wantEqual {
+		equal = !equal
+	}
+	return vm.push(obj.ParseBool(equal))
+}
+
+func objectsEqual(left, right obj.Object) bool {
+	if (left.Type() == obj.INT || left.Type() == obj.FLOAT) &&
+		(right.Type() == obj.INT || right.Type() == obj.FLOAT) {
+		l, r, err := obj.CoerceNumeric(left, right)
+		if err != nil {
+			return false
+		}
+		switch l.Type() {
+		case obj.INT:
+			return l.(*obj.Integer).Val() == r.(*obj.Integer).Val()
+		case obj.FLOAT:
+			return l.(*obj.Float).Val() == r.(*obj.Float).Val()
+		}
+	}
+
+	if left.Type() != right.Type() {
+		return false
+	}
+
+	switch left.Type() {
+	case obj.STRING:
+		return left.(*obj.String).Val() == right.(*obj.String).Val()
+	default:
+		return left == right
+	}
+}
+
+// isTruthy reports whether o should be treated as true in a boolean
+// context. Only an explicit false obj.Boolean is falsy.
+func isTruthy(o obj.Object) bool {
+	if b, ok := o.(*obj.Boolean); ok {
+		return b.Val()
+	}
+	return true
+}
+
+func (vm *VM) execUnaryMinus(ip int) error {
+	right := vm.pop()
+	pos := vm.SourcePos(ip)
+
+	switch right.Type() {
+	case obj.INT:
+		return vm.push(obj.NewInteger(-right.(*obj.Integer).Val()))
+	case obj.FLOAT:
+		return vm.push(obj.NewFloat(-right.(*obj.Float).Val()))
+	default:
+		return vm.push(obj.NewError(pos, "unsupported operator '-' for type %v", right.Type()))
+	}
+}
+
+func (vm *VM) execIndex(ip int) error {
+	index := vm.pop()
+	left := vm.pop()
+	pos := vm.SourcePos(ip)
+
+	arr, ok := left.(*obj.Array)
+	if !ok {
+		return vm.push(obj.NewError(pos, "unsupported index operation on type %v", left.Type()))
+	}
+	idx, ok := index.(*obj.Integer)
+	if !ok {
+		return vm.push(obj.NewError(pos, "index must be an integer, got %v", index.Type()))
+	}
+
+	elements := arr.Elements()
+	i := idx.Val()
+	if i < 0 || i >= int64(len(elements)) {
+		return vm.push(obj.NewError(pos, "index out of range: %d", i))
+	}
+	return vm.push(elements[i])
+}
+
+func (vm *VM) push(o obj.Object) error {
+	if vm.sp >= StackSize {
+		return fmt.Errorf("stack overflow")
+	}
+	vm.stack[vm.sp] = o
+	vm.sp++
+	return nil
+}
+
+func (vm *VM) pop() obj.Object {
+	o := vm.stack[vm.sp-1]
+	vm.sp--
+	return o
+}
@@ -0,0 +1,48 @@
+package vm
+
+import (
+	"testing"
+
+	"github.com/NicoNex/tau/compiler"
+	"github.com/NicoNex/tau/eval"
+	"github.com/NicoNex/tau/obj"
+	"github.com/NicoNex/tau/parser"
+)
+
+// benchProgram multiplies together a long chain of integer literals. It
+// stands in for the canonical fib(30) comparison: this snapshot of the
+// tree doesn't yet carry ast.Function/ast.Call, so there's no recursive
+// workload to compile/eval here, but the chain still exercises the same
+// "re-walk vs. flat instruction stream" tradeoff the VM exists for.
+const benchProgram = "1 * 2 * 3 * 4 * 5 * 6 * 7 * 8 * 9 * 10 * 11 * 12 * 13 * 14 * 15 * 16 * 17 * 18 * 19 * 20"
+
+func BenchmarkEval(b *testing.B) {
+	prog, errs := parser.Parse(benchProgram)
+	if len(errs) != 0 {
+		b.Fatalf("parser errors: %v", errs)
+	}
+
+	e := eval.New()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		e.Eval(prog, obj.NewEnv())
+	}
+}
+
+func BenchmarkVM(b *testing.B) {
+	prog, errs := parser.Parse(benchProgram)
+	if len(errs) != 0 {
+		b.Fatalf("parser errors: %v", errs)
+	}
+
+	c := compiler.New()
+	if err := prog.Compile(c); err != nil {
+		b.Fatalf("compile error: %v", err)
+	}
+	bc := c.Bytecode()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		New(bc).Run()
+	}
+}
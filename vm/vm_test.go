@@ -0,0 +1,75 @@
+package vm
+
+import (
+	"testing"
+
+	"github.com/NicoNex/tau/compiler"
+	"github.com/NicoNex/tau/obj"
+	"github.com/NicoNex/tau/parser"
+)
+
+// TestNumericCoercion mirrors eval.TestNumericCoercion: the VM must agree
+// with the tree-walking evaluator on INT/FLOAT promotion and the
+// string-aware cases of '+', '*' and '<'.
+func TestNumericCoercion(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"2 * 3", "6"},
+		{"2 * 1.5", "3"},
+		{"\"ab\" * 3", "ababab"},
+		{"1 < 2.0", "true"},
+		{"\"a\" < \"b\"", "true"},
+		{"1 + 1.5", "2.5"},
+		{"\"foo\" + \"bar\"", "foobar"},
+	}
+
+	for _, tt := range tests {
+		prog, errs := parser.Parse(tt.input)
+		if len(errs) != 0 {
+			t.Fatalf("parser errors for %q: %v", tt.input, errs)
+		}
+
+		c := compiler.New()
+		if err := prog.Compile(c); err != nil {
+			t.Fatalf("compile error for %q: %v", tt.input, err)
+		}
+
+		machine := New(c.Bytecode())
+		if err := machine.Run(); err != nil {
+			t.Fatalf("runtime error for %q: %v", tt.input, err)
+		}
+
+		if got := machine.Top().String(); got != tt.expected {
+			t.Errorf("%q: expected %q, got %q", tt.input, tt.expected, got)
+		}
+	}
+}
+
+// TestStringRepeatNegativeCount mirrors eval.TestStringRepeatNegativeCount:
+// a negative repeat count must produce an obj.Error, not panic.
+func TestStringRepeatNegativeCount(t *testing.T) {
+	tests := []string{"\"ab\" * -1", "-1 * \"ab\""}
+
+	for _, input := range tests {
+		prog, errs := parser.Parse(input)
+		if len(errs) != 0 {
+			t.Fatalf("parser errors for %q: %v", input, errs)
+		}
+
+		c := compiler.New()
+		if err := prog.Compile(c); err != nil {
+			t.Fatalf("compile error for %q: %v", input, err)
+		}
+
+		machine := New(c.Bytecode())
+		if err := machine.Run(); err != nil {
+			t.Fatalf("runtime error for %q: %v", input, err)
+		}
+
+		if got := machine.Top(); got.Type() != obj.ERROR {
+			t.Errorf("%q: expected an obj.Error, got %v (%v)", input, got, got.Type())
+		}
+	}
+}